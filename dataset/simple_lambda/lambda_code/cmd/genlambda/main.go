@@ -0,0 +1,28 @@
+// Command genlambda emits a "*_lambda_gen.go" wrapper for a plain business
+// function, turning it into a lambda.Start-compatible handler.
+//
+// Usage:
+//
+//	genlambda -file ./do.go -func Do
+package main
+
+import (
+	"flag"
+	"log"
+
+	"github.com/lgemc/terraform-llm/dataset/simple_lambda/lambda_code/gen"
+)
+
+func main() {
+	file := flag.String("file", "", "source file containing the target function")
+	funcName := flag.String("func", "", "name of the function to wrap")
+	flag.Parse()
+
+	if *file == "" || *funcName == "" {
+		log.Fatal("genlambda: -file and -func are required")
+	}
+
+	if err := gen.Generate(gen.Options{SourceFile: *file, FuncName: *funcName}); err != nil {
+		log.Fatalf("genlambda: %v", err)
+	}
+}
@@ -0,0 +1,51 @@
+// Command localdev builds the chunk's Lambda handler, packages it as a
+// zip, deploys it to a LocalStack endpoint, and invokes it with a JSON
+// payload.
+//
+// Usage:
+//
+//	localdev -source ../.. -zip ./bootstrap.zip -payload '{}' -endpoint http://localhost:4566
+package main
+
+import (
+	"context"
+	"flag"
+	"log"
+
+	"github.com/lgemc/terraform-llm/dataset/simple_lambda/lambda_code/localdev"
+)
+
+func main() {
+	endpoint := flag.String("endpoint", localdev.DefaultEndpoint, "LocalStack endpoint")
+	sourcePath := flag.String("source", ".", "path to the Go package containing the handler's main.go")
+	zipPath := flag.String("zip", "", "path to write the built handler zip to")
+	function := flag.String("function", "local-handler", "name to deploy the function as")
+	payload := flag.String("payload", "{}", "JSON payload to invoke with")
+	flag.Parse()
+
+	if *zipPath == "" {
+		log.Fatal("localdev: -zip is required")
+	}
+
+	if err := localdev.BuildAndZip(*sourcePath, *zipPath); err != nil {
+		log.Fatalf("localdev: %v", err)
+	}
+
+	ctx := context.Background()
+	client, err := localdev.NewClient(ctx, *endpoint)
+	if err != nil {
+		log.Fatalf("localdev: %v", err)
+	}
+
+	if err := client.Deploy(ctx, *function, *zipPath); err != nil {
+		log.Fatalf("localdev: %v", err)
+	}
+
+	resp, logs, err := client.Invoke(ctx, *function, []byte(*payload))
+	if err != nil {
+		log.Fatalf("localdev: %v", err)
+	}
+
+	log.Printf("response: %s", resp)
+	log.Printf("logs:\n%s", logs)
+}
@@ -0,0 +1,33 @@
+// Command scaffold generates a ready-to-deploy Lambda function skeleton
+// for a given language and prints the matching Terraform resource block.
+//
+// Usage:
+//
+//	scaffold -name my-function -lang go -out ./out
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/lgemc/terraform-llm/dataset/simple_lambda/lambda_code/scaffold"
+)
+
+func main() {
+	name := flag.String("name", "", "project name for the generated function")
+	lang := flag.String("lang", "go", "target language: go, python, node, ruby")
+	out := flag.String("out", ".", "directory to write the generated sources into")
+	flag.Parse()
+
+	if *name == "" {
+		log.Fatal("scaffold: -name is required")
+	}
+
+	tf, err := scaffold.Generate(*name, scaffold.Language(*lang), *out)
+	if err != nil {
+		log.Fatalf("scaffold: %v", err)
+	}
+
+	fmt.Println(tf)
+}
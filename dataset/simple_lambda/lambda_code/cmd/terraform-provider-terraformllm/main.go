@@ -0,0 +1,16 @@
+// Command terraform-provider-terraformllm serves the terraformllm
+// Terraform provider over the plugin protocol so Terraform can launch it
+// as a provider plugin.
+package main
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+
+	"github.com/lgemc/terraform-llm/dataset/simple_lambda/lambda_code/provider"
+)
+
+func main() {
+	plugin.Serve(&plugin.ServeOpts{
+		ProviderFunc: provider.Provider,
+	})
+}
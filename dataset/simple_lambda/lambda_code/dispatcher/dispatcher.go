@@ -0,0 +1,120 @@
+// Package dispatcher routes a raw Lambda event payload to a typed handler
+// based on which AWS event source produced it, so main.go does not need to
+// know in advance whether it was invoked by API Gateway, SQS, S3 or DynamoDB.
+package dispatcher
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+// Dispatcher holds one optional handler per supported AWS event source.
+// Handlers are registered with the On* methods and invoked by Handle once
+// the incoming payload has been classified.
+type Dispatcher struct {
+	apiGateway func(ctx context.Context, req events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)
+	sqs        func(ctx context.Context, evt events.SQSEvent) error
+	s3         func(ctx context.Context, evt events.S3Event) error
+	dynamoDB   func(ctx context.Context, evt events.DynamoDBEvent) error
+}
+
+// New returns an empty Dispatcher with no handlers registered.
+func New() *Dispatcher {
+	return &Dispatcher{}
+}
+
+// OnAPIGateway registers the handler invoked for API Gateway proxy requests.
+func (d *Dispatcher) OnAPIGateway(h func(ctx context.Context, req events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error)) {
+	d.apiGateway = h
+}
+
+// OnSQS registers the handler invoked for SQS events.
+func (d *Dispatcher) OnSQS(h func(ctx context.Context, evt events.SQSEvent) error) {
+	d.sqs = h
+}
+
+// OnS3 registers the handler invoked for S3 events.
+func (d *Dispatcher) OnS3(h func(ctx context.Context, evt events.S3Event) error) {
+	d.s3 = h
+}
+
+// OnDynamoDB registers the handler invoked for DynamoDB stream events.
+func (d *Dispatcher) OnDynamoDB(h func(ctx context.Context, evt events.DynamoDBEvent) error) {
+	d.dynamoDB = h
+}
+
+// Handle inspects raw, a JSON Lambda event payload, unmarshals it into the
+// matching events.* struct, and invokes the registered handler for it. The
+// return value is the typed handler's response (an
+// events.APIGatewayProxyResponse for API Gateway, nil for SQS, S3 and
+// DynamoDB); it is not marshalled here, lambda.Start does that.
+//
+// Classification is based on which source-specific fields are present in
+// the payload, since API Gateway, SQS, S3 and DynamoDB events carry no
+// common discriminator field.
+func (d *Dispatcher) Handle(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+	var probe struct {
+		HTTPMethod string        `json:"httpMethod"`
+		Records    []probeRecord `json:"Records"`
+		RouteKey   string        `json:"routeKey"`
+	}
+	if err := json.Unmarshal(raw, &probe); err != nil {
+		return nil, fmt.Errorf("dispatcher: unmarshal payload: %w", err)
+	}
+
+	switch {
+	case probe.HTTPMethod != "" || probe.RouteKey != "":
+		if d.apiGateway == nil {
+			return nil, fmt.Errorf("dispatcher: no API Gateway handler registered")
+		}
+		var req events.APIGatewayProxyRequest
+		if err := json.Unmarshal(raw, &req); err != nil {
+			return nil, fmt.Errorf("dispatcher: unmarshal APIGatewayProxyRequest: %w", err)
+		}
+		return d.apiGateway(ctx, req)
+
+	case len(probe.Records) > 0 && probe.Records[0].EventSource == "aws:sqs":
+		if d.sqs == nil {
+			return nil, fmt.Errorf("dispatcher: no SQS handler registered")
+		}
+		var evt events.SQSEvent
+		if err := json.Unmarshal(raw, &evt); err != nil {
+			return nil, fmt.Errorf("dispatcher: unmarshal SQSEvent: %w", err)
+		}
+		return nil, d.sqs(ctx, evt)
+
+	case len(probe.Records) > 0 && probe.Records[0].EventSource == "aws:s3":
+		if d.s3 == nil {
+			return nil, fmt.Errorf("dispatcher: no S3 handler registered")
+		}
+		var evt events.S3Event
+		if err := json.Unmarshal(raw, &evt); err != nil {
+			return nil, fmt.Errorf("dispatcher: unmarshal S3Event: %w", err)
+		}
+		return nil, d.s3(ctx, evt)
+
+	case len(probe.Records) > 0 && probe.Records[0].EventSourceARN != "" && probe.Records[0].EventSource == "aws:dynamodb":
+		if d.dynamoDB == nil {
+			return nil, fmt.Errorf("dispatcher: no DynamoDB handler registered")
+		}
+		var evt events.DynamoDBEvent
+		if err := json.Unmarshal(raw, &evt); err != nil {
+			return nil, fmt.Errorf("dispatcher: unmarshal DynamoDBEvent: %w", err)
+		}
+		return nil, d.dynamoDB(ctx, evt)
+
+	default:
+		return nil, fmt.Errorf("dispatcher: unrecognized event payload")
+	}
+}
+
+// probeRecord pulls out just enough of a Records[] entry to tell SQS, S3
+// and DynamoDB events apart without committing to one of their full struct
+// shapes.
+type probeRecord struct {
+	EventSource    string `json:"eventSource"`
+	EventSourceARN string `json:"eventSourceARN"`
+}
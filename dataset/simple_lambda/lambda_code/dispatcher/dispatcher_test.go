@@ -0,0 +1,101 @@
+package dispatcher
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-lambda-go/events"
+)
+
+func TestHandleRoutesAPIGateway(t *testing.T) {
+	d := New()
+	var got events.APIGatewayProxyRequest
+	d.OnAPIGateway(func(ctx context.Context, req events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+		got = req
+		return events.APIGatewayProxyResponse{StatusCode: 200}, nil
+	})
+
+	raw := json.RawMessage(`{"httpMethod":"GET","path":"/hello"}`)
+	resp, err := d.Handle(context.Background(), raw)
+	if err != nil {
+		t.Fatalf("Handle returned error: %v", err)
+	}
+	if got.HTTPMethod != "GET" || got.Path != "/hello" {
+		t.Fatalf("unexpected decoded request: %+v", got)
+	}
+	apiResp, ok := resp.(events.APIGatewayProxyResponse)
+	if !ok || apiResp.StatusCode != 200 {
+		t.Fatalf("unexpected response: %+v", resp)
+	}
+}
+
+func TestHandleRoutesSQS(t *testing.T) {
+	d := New()
+	var called bool
+	d.OnSQS(func(ctx context.Context, evt events.SQSEvent) error {
+		called = true
+		if len(evt.Records) != 1 || evt.Records[0].Body != "hello" {
+			t.Fatalf("unexpected SQS event: %+v", evt)
+		}
+		return nil
+	})
+
+	raw := json.RawMessage(`{"Records":[{"eventSource":"aws:sqs","body":"hello"}]}`)
+	if _, err := d.Handle(context.Background(), raw); err != nil {
+		t.Fatalf("Handle returned error: %v", err)
+	}
+	if !called {
+		t.Fatal("SQS handler was not invoked")
+	}
+}
+
+func TestHandleRoutesS3(t *testing.T) {
+	d := New()
+	var called bool
+	d.OnS3(func(ctx context.Context, evt events.S3Event) error {
+		called = true
+		return nil
+	})
+
+	raw := json.RawMessage(`{"Records":[{"eventSource":"aws:s3"}]}`)
+	if _, err := d.Handle(context.Background(), raw); err != nil {
+		t.Fatalf("Handle returned error: %v", err)
+	}
+	if !called {
+		t.Fatal("S3 handler was not invoked")
+	}
+}
+
+func TestHandleRoutesDynamoDB(t *testing.T) {
+	d := New()
+	var called bool
+	d.OnDynamoDB(func(ctx context.Context, evt events.DynamoDBEvent) error {
+		called = true
+		return nil
+	})
+
+	raw := json.RawMessage(`{"Records":[{"eventSource":"aws:dynamodb","eventSourceARN":"arn:aws:dynamodb:table"}]}`)
+	if _, err := d.Handle(context.Background(), raw); err != nil {
+		t.Fatalf("Handle returned error: %v", err)
+	}
+	if !called {
+		t.Fatal("DynamoDB handler was not invoked")
+	}
+}
+
+func TestHandleUnregisteredHandlerErrors(t *testing.T) {
+	d := New()
+	raw := json.RawMessage(`{"httpMethod":"GET"}`)
+	if _, err := d.Handle(context.Background(), raw); err == nil {
+		t.Fatal("expected error for unregistered API Gateway handler")
+	}
+}
+
+func TestHandleUnrecognizedPayloadErrors(t *testing.T) {
+	d := New()
+	raw := json.RawMessage(`{"foo":"bar"}`)
+	if _, err := d.Handle(context.Background(), raw); err == nil {
+		t.Fatal("expected error for unrecognized payload")
+	}
+}
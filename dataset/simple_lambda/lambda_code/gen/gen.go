@@ -0,0 +1,255 @@
+// Package gen generates Lambda boilerplate (an event struct, a Provider
+// interface for injected dependencies, and a lambda.Start wrapper) from a
+// plain, already-written business function, so
+// that boilerplate doesn't have to be hand-written for every new function.
+package gen
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// providerTypeHints lists the substrings of a parameter's type name that
+// mark it as a dependency the caller must supply at startup (logger,
+// database handle, config), rather than a field coming from the event
+// payload. This mirrors the injected parameters in the emitmain plugin
+// this generator is modeled on.
+var providerTypeHints = []string{"Logger", "DB", "Config", "Provider"}
+
+// Param describes one parameter of the target function, already classified
+// as either an event field or a provider dependency.
+type Param struct {
+	Name     string // original parameter name, e.g. "targetID"
+	Type     string // source-level type expression, e.g. "*bool"
+	JSONName string // JSON field name once it becomes part of the event struct
+	Provider bool   // true if this is a provider-supplied dependency
+}
+
+// Options configures a single generation pass.
+type Options struct {
+	SourceFile string            // path to the .go file containing FuncName
+	FuncName   string            // name of the business function to wrap
+	Renames    map[string]string // parameter name -> JSON field name overrides
+}
+
+// Generate parses SourceFile, locates FuncName, classifies its parameters
+// into event fields vs provider dependencies, and writes the generated
+// wrapper next to SourceFile as "<base>_<funcname>_lambda_gen.go", where
+// <base> is SourceFile without ".go" and <funcname> is FuncName in lower
+// case (e.g. do.go and Do give do_do_lambda_gen.go).
+func Generate(opts Options) error {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, opts.SourceFile, nil, parser.ParseComments)
+	if err != nil {
+		return fmt.Errorf("gen: parse %s: %w", opts.SourceFile, err)
+	}
+
+	decl := findFunc(file, opts.FuncName)
+	if decl == nil {
+		return fmt.Errorf("gen: function %q not found in %s", opts.FuncName, opts.SourceFile)
+	}
+	if decl.Type.Results == nil || len(decl.Type.Results.List) != 2 {
+		return fmt.Errorf("gen: %s must return (Response, error)", opts.FuncName)
+	}
+
+	params, err := classifyParams(decl, opts.Renames)
+	if err != nil {
+		return err
+	}
+	respExpr := decl.Type.Results.List[0].Type
+	respType := exprString(respExpr)
+
+	imports := collectImports(file, decl, respExpr)
+
+	out := render(file.Name.Name, opts.FuncName, respType, params, imports)
+	formatted, err := format.Source([]byte(out))
+	if err != nil {
+		return fmt.Errorf("gen: format generated source: %w", err)
+	}
+
+	outPath := strings.TrimSuffix(opts.SourceFile, ".go") + "_" + strings.ToLower(opts.FuncName) + "_lambda_gen.go"
+	if err := os.WriteFile(outPath, formatted, 0o644); err != nil {
+		return fmt.Errorf("gen: write %s: %w", outPath, err)
+	}
+	return nil
+}
+
+// findFunc returns the top-level function declaration named name, or nil.
+func findFunc(file *ast.File, name string) *ast.FuncDecl {
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if ok && fn.Recv == nil && fn.Name.Name == name {
+			return fn
+		}
+	}
+	return nil
+}
+
+// classifyParams splits decl's parameters into event fields and provider
+// dependencies based on providerTypeHints, applying any JSON renames.
+func classifyParams(decl *ast.FuncDecl, renames map[string]string) ([]Param, error) {
+	var params []Param
+	for _, field := range decl.Type.Params.List {
+		typeStr := exprString(field.Type)
+		for _, name := range field.Names {
+			p := Param{
+				Name:     name.Name,
+				Type:     typeStr,
+				JSONName: strings.Title(name.Name),
+				Provider: isProviderType(typeStr),
+			}
+			if renamed, ok := renames[name.Name]; ok {
+				p.JSONName = renamed
+			}
+			params = append(params, p)
+		}
+	}
+	return params, nil
+}
+
+// isProviderType reports whether typeStr names a dependency that should be
+// supplied by a Provider rather than decoded from the event payload.
+func isProviderType(typeStr string) bool {
+	for _, hint := range providerTypeHints {
+		if strings.Contains(typeStr, hint) {
+			return true
+		}
+	}
+	return false
+}
+
+// collectImports walks decl's parameter and result types for package
+// selectors (e.g. the "log" in "*log.Logger") and resolves each one back
+// to the import path file declared it under, so the generated file can
+// import everything its own event/provider types reference.
+func collectImports(file *ast.File, decl *ast.FuncDecl, respExpr ast.Expr) []string {
+	pathByName := make(map[string]string, len(file.Imports))
+	for _, imp := range file.Imports {
+		path, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			continue
+		}
+		name := imp.Name
+		var alias string
+		if name != nil {
+			alias = name.Name
+		} else {
+			alias = path[strings.LastIndex(path, "/")+1:]
+		}
+		pathByName[alias] = path
+	}
+
+	used := make(map[string]bool)
+	record := func(expr ast.Expr) {
+		ast.Inspect(expr, func(n ast.Node) bool {
+			sel, ok := n.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				if path, ok := pathByName[ident.Name]; ok {
+					used[path] = true
+				}
+			}
+			return true
+		})
+	}
+
+	for _, field := range decl.Type.Params.List {
+		record(field.Type)
+	}
+	record(respExpr)
+
+	imports := make([]string, 0, len(used))
+	for path := range used {
+		imports = append(imports, path)
+	}
+	sort.Strings(imports)
+	return imports
+}
+
+// exprString renders an ast.Expr type back into Go source syntax.
+func exprString(expr ast.Expr) string {
+	return types.ExprString(expr)
+}
+
+// render emits the generated file: a <FuncName>Event struct for the
+// non-injected parameters, a <FuncName>Provider interface for the injected
+// ones, a <FuncName>Handler(getProvider) wrapper that returns the handler,
+// and a Run function that passes that wrapper to lambda.Start.
+func render(pkg, funcName, respType string, params []Param, extraImports []string) string {
+	var eventFields, providerMethods, eventArgs strings.Builder
+	for _, p := range params {
+		if p.Provider {
+			providerMethods.WriteString(fmt.Sprintf("\t%s() %s\n", strings.Title(p.Name), p.Type))
+			eventArgs.WriteString(fmt.Sprintf("provider.%s(), ", strings.Title(p.Name)))
+		} else {
+			eventFields.WriteString(fmt.Sprintf("\t%s %s `json:%q`\n", strings.Title(p.Name), p.Type, p.JSONName))
+			eventArgs.WriteString(fmt.Sprintf("event.%s, ", strings.Title(p.Name)))
+		}
+	}
+
+	var extra strings.Builder
+	for _, path := range extraImports {
+		extra.WriteString(fmt.Sprintf("\t%q\n", path))
+	}
+
+	return fmt.Sprintf(`// Code generated by gen from %s; DO NOT EDIT.
+
+package %s
+
+import (
+	"context"
+
+%s	"github.com/aws/aws-lambda-go/lambda"
+)
+
+// %sEvent is the event payload %sHandler decodes before calling %s.
+type %sEvent struct {
+%s}
+
+// %sProvider supplies the dependencies %s needs that don't come from the
+// event payload.
+type %sProvider interface {
+%s}
+
+// %sHandler wraps %s as a lambda.Start-compatible handler, decoding the
+// event into a %sEvent and pulling dependencies from the Provider returned
+// by getProvider.
+func %sHandler(getProvider func(ctx context.Context) (%sProvider, error)) func(ctx context.Context, event %sEvent) (%s, error) {
+	return func(ctx context.Context, event %sEvent) (%s, error) {
+		provider, err := getProvider(ctx)
+		if err != nil {
+			var zero %s
+			return zero, err
+		}
+		return %s(%s)
+	}
+}
+
+// Run starts the generated handler with lambda.Start.
+func Run(getProvider func(ctx context.Context) (%sProvider, error)) {
+	lambda.Start(%sHandler(getProvider))
+}
+`,
+		funcName, pkg,
+		extra.String(),
+		funcName, funcName, funcName, funcName, eventFields.String(),
+		funcName, funcName, funcName, providerMethods.String(),
+		funcName, funcName, funcName,
+		funcName, funcName, funcName, respType,
+		funcName, respType,
+		respType,
+		funcName, eventArgs.String(),
+		funcName,
+		funcName,
+	)
+}
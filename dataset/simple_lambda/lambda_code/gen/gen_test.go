@@ -0,0 +1,63 @@
+package gen
+
+import (
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const businessSource = `package business
+
+import "log"
+
+type DoInput struct {
+	Name string
+}
+
+type Response struct {
+	Message string ` + "`json:\"message\"`" + `
+}
+
+func Do(logger *log.Logger, data DoInput, debug *bool, targetID string) (Response, error) {
+	return Response{Message: targetID}, nil
+}
+`
+
+func TestGenerateAddsSelectorImports(t *testing.T) {
+	dir := t.TempDir()
+	srcPath := filepath.Join(dir, "do.go")
+	if err := os.WriteFile(srcPath, []byte(businessSource), 0o644); err != nil {
+		t.Fatalf("write source: %v", err)
+	}
+
+	if err := Generate(Options{SourceFile: srcPath, FuncName: "Do"}); err != nil {
+		t.Fatalf("Generate returned error: %v", err)
+	}
+
+	outPath := filepath.Join(dir, "do_do_lambda_gen.go")
+	out, err := os.ReadFile(outPath)
+	if err != nil {
+		t.Fatalf("read generated file: %v", err)
+	}
+
+	if !strings.Contains(string(out), `"log"`) {
+		t.Fatalf("generated file is missing the \"log\" import needed for *log.Logger:\n%s", out)
+	}
+	if _, err := format.Source(out); err != nil {
+		t.Fatalf("generated file is not valid Go source: %v\n%s", err, out)
+	}
+}
+
+func TestIsProviderTypeSeparatesProviderFromEventFields(t *testing.T) {
+	params := []Param{
+		{Name: "logger", Type: "*log.Logger", Provider: true},
+		{Name: "data", Type: "DoInput", Provider: false},
+	}
+	for _, p := range params {
+		if got := isProviderType(p.Type); got != p.Provider {
+			t.Errorf("isProviderType(%q) = %v, want %v", p.Type, got, p.Provider)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package localdev
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/base64"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDecodeLog(t *testing.T) {
+	want := "START RequestId: abc\nEND RequestId: abc\n"
+	encoded := base64.StdEncoding.EncodeToString([]byte(want))
+
+	if got := decodeLog(&encoded); got != want {
+		t.Errorf("decodeLog(%q) = %q, want %q", encoded, got, want)
+	}
+}
+
+func TestDecodeLogNil(t *testing.T) {
+	if got := decodeLog(nil); got != "" {
+		t.Errorf("decodeLog(nil) = %q, want empty string", got)
+	}
+}
+
+func TestDecodeLogInvalidBase64(t *testing.T) {
+	invalid := "not-valid-base64!!"
+	if got := decodeLog(&invalid); got != "" {
+		t.Errorf("decodeLog(%q) = %q, want empty string on decode error", invalid, got)
+	}
+}
+
+func TestBuildZip(t *testing.T) {
+	dir := t.TempDir()
+	binContents := []byte("fake binary contents")
+	if err := os.WriteFile(filepath.Join(dir, "bootstrap"), binContents, 0o755); err != nil {
+		t.Fatalf("write fake binary: %v", err)
+	}
+
+	zipPath := filepath.Join(dir, "out.zip")
+	if err := BuildZip(dir, "bootstrap", zipPath); err != nil {
+		t.Fatalf("BuildZip returned error: %v", err)
+	}
+
+	data, err := os.ReadFile(zipPath)
+	if err != nil {
+		t.Fatalf("read zip: %v", err)
+	}
+
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("open zip: %v", err)
+	}
+	if len(r.File) != 1 || r.File[0].Name != "bootstrap" {
+		t.Fatalf("unexpected zip entries: %+v", r.File)
+	}
+
+	rc, err := r.File[0].Open()
+	if err != nil {
+		t.Fatalf("open zip entry: %v", err)
+	}
+	defer rc.Close()
+	got, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("read zip entry: %v", err)
+	}
+	if !bytes.Equal(got, binContents) {
+		t.Errorf("zip entry contents = %q, want %q", got, binContents)
+	}
+}
+
+func TestBuildZipMissingBinary(t *testing.T) {
+	dir := t.TempDir()
+	if err := BuildZip(dir, "missing", filepath.Join(dir, "out.zip")); err == nil {
+		t.Fatal("expected error when the binary to zip does not exist")
+	}
+}
@@ -2,15 +2,38 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/aws/aws-lambda-go/events"
 	"github.com/aws/aws-lambda-go/lambda"
+
+	"github.com/lgemc/terraform-llm/dataset/simple_lambda/lambda_code/dispatcher"
 )
 
 type Response struct {
 	Message string `json:"message"`
 }
 
-func handler(ctx context.Context) (Response, error) {
-	return Response{Message: "Hello from Go Lambda!"}, nil
+// apiGatewayHandler is the typed handler invoked for API Gateway proxy
+// requests. It replaces the old context-only handler so the function can
+// also be wired up as an HTTP endpoint.
+func apiGatewayHandler(ctx context.Context, req events.APIGatewayProxyRequest) (events.APIGatewayProxyResponse, error) {
+	body, err := json.Marshal(Response{Message: "Hello from Go Lambda!"})
+	if err != nil {
+		return events.APIGatewayProxyResponse{}, err
+	}
+	return events.APIGatewayProxyResponse{
+		StatusCode: http.StatusOK,
+		Headers:    map[string]string{"Content-Type": "application/json"},
+		Body:       string(body),
+	}, nil
+}
+
+func handler(ctx context.Context, raw json.RawMessage) (interface{}, error) {
+	d := dispatcher.New()
+	d.OnAPIGateway(apiGatewayHandler)
+	return d.Handle(ctx, raw)
 }
 
 func main() {
@@ -0,0 +1,170 @@
+// Package provider implements a small Terraform provider that builds,
+// zips and invokes the Go Lambda handler in this chunk directly from
+// Terraform, so a plan/apply can consume its Response.Message as a typed
+// attribute without a separate deploy-then-invoke step.
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/lgemc/terraform-llm/dataset/simple_lambda/lambda_code/localdev"
+)
+
+// Provider returns the terraformllm Terraform provider, exposing
+// terraformllm_lambda_function as a resource and
+// terraformllm_lambda_invocation as a data source.
+func Provider() *schema.Provider {
+	return &schema.Provider{
+		ResourcesMap: map[string]*schema.Resource{
+			"terraformllm_lambda_function": resourceLambdaFunction(),
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"terraformllm_lambda_invocation": dataSourceLambdaInvocation(),
+		},
+	}
+}
+
+// resourceLambdaFunction builds the Go binary at source_path, zips it, and
+// writes the artifact to output_path for a downstream aws_lambda_function
+// resource to consume as its filename/source_code_hash.
+func resourceLambdaFunction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLambdaFunctionCreate,
+		ReadContext:   resourceLambdaFunctionRead,
+		DeleteContext: resourceLambdaFunctionDelete,
+		Schema: map[string]*schema.Schema{
+			"source_path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path to the Go package containing the handler's main.go.",
+			},
+			"output_path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path to write the built handler zip to.",
+			},
+		},
+	}
+}
+
+func resourceLambdaFunctionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sourcePath := d.Get("source_path").(string)
+	outputPath := d.Get("output_path").(string)
+
+	if err := localdev.BuildAndZip(sourcePath, outputPath); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(outputPath)
+	return nil
+}
+
+func resourceLambdaFunctionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if _, err := os.Stat(d.Id()); os.IsNotExist(err) {
+		d.SetId("")
+	}
+	return nil
+}
+
+func resourceLambdaFunctionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := os.Remove(d.Id()); err != nil && !os.IsNotExist(err) {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// dataSourceLambdaInvocation deploys the built handler zip to a LocalStack
+// Lambda endpoint and invokes it at plan/apply time, surfacing the decoded
+// Response.Message as a computed attribute. It goes through LocalStack
+// rather than exec'ing the binary directly because a lambda.Start handler
+// refuses to run outside a Lambda Runtime API environment, which only
+// LocalStack (or real AWS) provides.
+func dataSourceLambdaInvocation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceLambdaInvocationRead,
+		Schema: map[string]*schema.Schema{
+			"zip_path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path to the built handler zip, as produced by terraformllm_lambda_function.",
+			},
+			"function_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name to deploy the function as on the LocalStack endpoint.",
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     localdev.DefaultEndpoint,
+				Description: "LocalStack endpoint to deploy and invoke the function against.",
+			},
+			"payload": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "{}",
+				Description: "JSON event payload passed to the handler.",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Response.Message field returned by the handler.",
+			},
+		},
+	}
+}
+
+func dataSourceLambdaInvocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	zipPath := d.Get("zip_path").(string)
+	functionName := d.Get("function_name").(string)
+	endpoint := d.Get("endpoint").(string)
+	payload := d.Get("payload").(string)
+
+	client, err := localdev.NewClient(ctx, endpoint)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err := client.Deploy(ctx, functionName, zipPath); err != nil {
+		return diag.FromErr(err)
+	}
+
+	out, _, err := client.Invoke(ctx, functionName, []byte(payload))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// An API Gateway proxy response carries the handler's Response as a
+	// JSON string in its body.
+	var proxy struct {
+		Body *string `json:"body"`
+	}
+	if err := json.Unmarshal(out, &proxy); err == nil && proxy.Body != nil {
+		out = []byte(*proxy.Body)
+	}
+
+	var resp response
+	if err := json.Unmarshal(out, &resp); err != nil {
+		return diag.FromErr(fmt.Errorf("provider: decode response: %w", err))
+	}
+
+	d.SetId(functionName)
+	if err := d.Set("message", resp.Message); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// response mirrors main.Response so the data source can decode the
+// handler's output, bare or inside an API Gateway proxy response's body,
+// without importing the main package.
+type response struct {
+	Message string `json:"message"`
+}
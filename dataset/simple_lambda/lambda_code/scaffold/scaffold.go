@@ -0,0 +1,153 @@
+// Package scaffold generates ready-to-deploy Lambda function skeletons for
+// a handful of languages, plus the Terraform resource block needed to
+// deploy the generated artifact as an aws_lambda_function.
+package scaffold
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Language identifies one of the supported Lambda runtimes.
+type Language string
+
+const (
+	Go     Language = "go"
+	Python Language = "python"
+	Node   Language = "node"
+	Ruby   Language = "ruby"
+)
+
+// languageSpec describes everything the generator needs to lay down a
+// skeleton for one language: the name of the handler source file, the name
+// of its dependency manifest, and the template bodies for each.
+type languageSpec struct {
+	AppFile      string
+	DepsFile     string
+	AppTemplate  string
+	DepsTemplate string
+	Runtime      string
+	Handler      string
+}
+
+// LanguageMapper maps each supported Language to its scaffolding spec.
+var LanguageMapper = map[Language]languageSpec{
+	Go: {
+		AppFile:  "main.go",
+		DepsFile: "go.mod",
+		AppTemplate: `package main
+
+import (
+	"context"
+	"github.com/aws/aws-lambda-go/lambda"
+)
+
+type Response struct {
+	Message string ` + "`json:\"message\"`" + `
+}
+
+func handler(ctx context.Context) (Response, error) {
+	return Response{Message: "Hello from %s!"}, nil
+}
+
+func main() {
+	lambda.Start(handler)
+}
+`,
+		DepsTemplate: `module %s
+
+go 1.21
+
+require github.com/aws/aws-lambda-go v1.41.0
+`,
+		Runtime: "provided.al2023",
+		Handler: "bootstrap",
+	},
+	Python: {
+		AppFile:  "app.py",
+		DepsFile: "requirements.txt",
+		AppTemplate: `def handler(event, context):
+    return {"message": "Hello from %s!"}
+`,
+		DepsTemplate: "",
+		Runtime:      "python3.12",
+		Handler:      "app.handler",
+	},
+	Node: {
+		AppFile:  "index.js",
+		DepsFile: "package.json",
+		AppTemplate: `exports.handler = async (event) => {
+  return { message: "Hello from %s!" };
+};
+`,
+		DepsTemplate: `{
+  "name": "%s",
+  "version": "1.0.0",
+  "main": "index.js"
+}
+`,
+		Runtime: "nodejs20.x",
+		Handler: "index.handler",
+	},
+	Ruby: {
+		AppFile:  "handler.rb",
+		DepsFile: "Gemfile",
+		AppTemplate: `def handler(event:, context:)
+  { message: "Hello from %s!" }
+end
+`,
+		DepsTemplate: `source "https://rubygems.org"
+`,
+		Runtime: "ruby3.2",
+		Handler: "handler.handler",
+	},
+}
+
+// Generate renders a skeleton for lang under targetDir, naming the project
+// name. It writes the handler source and dependency manifest for that
+// language and returns the Terraform aws_lambda_function resource block
+// wired to the generated artifact.
+func Generate(name string, lang Language, targetDir string) (string, error) {
+	spec, ok := LanguageMapper[lang]
+	if !ok {
+		return "", fmt.Errorf("scaffold: unsupported language %q", lang)
+	}
+
+	if err := os.MkdirAll(targetDir, 0o755); err != nil {
+		return "", fmt.Errorf("scaffold: create target dir: %w", err)
+	}
+
+	appBody := fmt.Sprintf(spec.AppTemplate, name)
+	if err := os.WriteFile(filepath.Join(targetDir, spec.AppFile), []byte(appBody), 0o644); err != nil {
+		return "", fmt.Errorf("scaffold: write %s: %w", spec.AppFile, err)
+	}
+
+	if spec.DepsFile != "" {
+		depsBody := spec.DepsTemplate
+		if strings.Contains(depsBody, "%s") {
+			depsBody = fmt.Sprintf(depsBody, name)
+		}
+		if err := os.WriteFile(filepath.Join(targetDir, spec.DepsFile), []byte(depsBody), 0o644); err != nil {
+			return "", fmt.Errorf("scaffold: write %s: %w", spec.DepsFile, err)
+		}
+	}
+
+	return terraformBlock(name, spec), nil
+}
+
+// terraformBlock renders the aws_lambda_function resource for the
+// generated skeleton, pointing at a zip archive the caller is expected to
+// build from targetDir before running terraform apply.
+func terraformBlock(name string, spec languageSpec) string {
+	return fmt.Sprintf(`resource "aws_lambda_function" %q {
+  function_name    = %q
+  filename         = "${path.module}/%s.zip"
+  source_code_hash = filebase64sha256("${path.module}/%s.zip")
+  handler          = %q
+  runtime          = %q
+  role             = aws_iam_role.lambda_exec.arn
+}
+`, name, name, name, name, spec.Handler, spec.Runtime)
+}
@@ -0,0 +1,79 @@
+package scaffold
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerateAllLanguages(t *testing.T) {
+	for lang, spec := range LanguageMapper {
+		lang, spec := lang, spec
+		t.Run(string(lang), func(t *testing.T) {
+			dir := t.TempDir()
+			tf, err := Generate("myproj", lang, dir)
+			if err != nil {
+				t.Fatalf("Generate returned error: %v", err)
+			}
+
+			appBody, err := os.ReadFile(filepath.Join(dir, spec.AppFile))
+			if err != nil {
+				t.Fatalf("read %s: %v", spec.AppFile, err)
+			}
+			if !strings.Contains(string(appBody), "myproj") {
+				t.Errorf("%s does not mention the project name:\n%s", spec.AppFile, appBody)
+			}
+
+			if spec.DepsFile != "" {
+				depsBody, err := os.ReadFile(filepath.Join(dir, spec.DepsFile))
+				if err != nil {
+					t.Fatalf("read %s: %v", spec.DepsFile, err)
+				}
+				if strings.Contains(string(depsBody), "%!") {
+					t.Errorf("%s contains a Sprintf verb error:\n%s", spec.DepsFile, depsBody)
+				}
+			}
+
+			if !strings.Contains(tf, `resource "aws_lambda_function" "myproj"`) {
+				t.Errorf("terraform block does not declare the expected resource:\n%s", tf)
+			}
+		})
+	}
+}
+
+func TestGenerateRubyDepsHasNoPlaceholder(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := Generate("myproj", Ruby, dir); err != nil {
+		t.Fatalf("Generate returned error: %v", err)
+	}
+
+	gemfile, err := os.ReadFile(filepath.Join(dir, "Gemfile"))
+	if err != nil {
+		t.Fatalf("read Gemfile: %v", err)
+	}
+	if string(gemfile) != LanguageMapper[Ruby].DepsTemplate {
+		t.Errorf("Gemfile was modified by Sprintf even though it declares no placeholder:\ngot:  %q\nwant: %q", gemfile, LanguageMapper[Ruby].DepsTemplate)
+	}
+}
+
+func TestGeneratePythonDepsIsEmpty(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := Generate("myproj", Python, dir); err != nil {
+		t.Fatalf("Generate returned error: %v", err)
+	}
+
+	reqs, err := os.ReadFile(filepath.Join(dir, "requirements.txt"))
+	if err != nil {
+		t.Fatalf("read requirements.txt: %v", err)
+	}
+	if len(reqs) != 0 {
+		t.Errorf("requirements.txt should be empty, got %q", reqs)
+	}
+}
+
+func TestGenerateUnsupportedLanguage(t *testing.T) {
+	if _, err := Generate("myproj", Language("cobol"), t.TempDir()); err == nil {
+		t.Fatal("expected error for unsupported language")
+	}
+}